@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestBackoffDelayWithinBounds(t *testing.T) {
+	for failures := 1; failures <= 10; failures++ {
+		d := backoffDelay(failures)
+		if d < 0 {
+			t.Fatalf("failures=%d: backoffDelay returned negative duration %v", failures, d)
+		}
+		if d > backoffMax {
+			t.Errorf("failures=%d: backoffDelay = %v, want <= %v", failures, d, backoffMax)
+		}
+	}
+}
+
+func TestBackoffDelayCapsAtMax(t *testing.T) {
+	// A large failure count would overflow the shift; it should saturate at
+	// backoffMax rather than wrap around to a small or negative duration.
+	d := backoffDelay(63)
+	if d > backoffMax {
+		t.Errorf("backoffDelay(63) = %v, want <= %v", d, backoffMax)
+	}
+	if d < 0 {
+		t.Errorf("backoffDelay(63) = %v, want non-negative", d)
+	}
+}
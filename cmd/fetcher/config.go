@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/notify"
+)
+
+// Config is the on-disk fetcher configuration: the set of notification
+// sinks to fan out a scrape to.
+type Config struct {
+	Sinks []SinkConfig `yaml:"sinks"`
+}
+
+// SinkConfig selects one notification sink and its settings. Exactly one of
+// the typed fields matching Type should be populated.
+type SinkConfig struct {
+	Type        string             `yaml:"type"` // discord, webhook, matrix, xmpp, activitypub
+	Discord     *DiscordConfig     `yaml:"discord,omitempty"`
+	Webhook     *WebhookConfig     `yaml:"webhook,omitempty"`
+	Matrix      *MatrixConfig      `yaml:"matrix,omitempty"`
+	XMPP        *XMPPConfig        `yaml:"xmpp,omitempty"`
+	ActivityPub *ActivityPubConfig `yaml:"activitypub,omitempty"`
+}
+
+type DiscordConfig struct {
+	WebhookURL string `yaml:"webhook_url"`
+}
+
+type WebhookConfig struct {
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+type MatrixConfig struct {
+	HomeserverURL string `yaml:"homeserver_url"`
+	RoomID        string `yaml:"room_id"`
+	AccessToken   string `yaml:"access_token"`
+}
+
+type XMPPConfig struct {
+	JID      string `yaml:"jid"`
+	Password string `yaml:"password"`
+	ToJID    string `yaml:"to_jid"`
+	Insecure bool   `yaml:"insecure"`
+}
+
+type ActivityPubConfig struct {
+	ActorID        string `yaml:"actor_id"`
+	KeyID          string `yaml:"key_id"`
+	PrivateKeyPath string `yaml:"private_key_path"`
+	SharedInboxURL string `yaml:"shared_inbox_url"`
+}
+
+// loadConfig reads and parses the YAML fetcher config at path.
+func loadConfig(path string) (*Config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// Notifier builds the concrete notify.Notifier described by this SinkConfig.
+func (s *SinkConfig) Notifier() (notify.Notifier, error) {
+	switch s.Type {
+	case "discord":
+		if s.Discord == nil {
+			return nil, fmt.Errorf("sink type discord requires a discord config block")
+		}
+		return &notify.DiscordNotifier{WebhookURL: s.Discord.WebhookURL}, nil
+	case "webhook":
+		if s.Webhook == nil {
+			return nil, fmt.Errorf("sink type webhook requires a webhook config block")
+		}
+		return &notify.WebhookNotifier{URL: s.Webhook.URL, Headers: s.Webhook.Headers}, nil
+	case "matrix":
+		if s.Matrix == nil {
+			return nil, fmt.Errorf("sink type matrix requires a matrix config block")
+		}
+		return &notify.MatrixNotifier{
+			HomeserverURL: s.Matrix.HomeserverURL,
+			RoomID:        s.Matrix.RoomID,
+			AccessToken:   s.Matrix.AccessToken,
+		}, nil
+	case "xmpp":
+		if s.XMPP == nil {
+			return nil, fmt.Errorf("sink type xmpp requires an xmpp config block")
+		}
+		return &notify.XMPPNotifier{
+			JID:      s.XMPP.JID,
+			Password: s.XMPP.Password,
+			ToJID:    s.XMPP.ToJID,
+			Insecure: s.XMPP.Insecure,
+		}, nil
+	case "activitypub":
+		if s.ActivityPub == nil {
+			return nil, fmt.Errorf("sink type activitypub requires an activitypub config block")
+		}
+		key, err := os.ReadFile(s.ActivityPub.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read activitypub private key: %w", err)
+		}
+		return &notify.ActivityPubNotifier{
+			ActorID:        s.ActivityPub.ActorID,
+			KeyID:          s.ActivityPub.KeyID,
+			PrivateKeyPEM:  key,
+			SharedInboxURL: s.ActivityPub.SharedInboxURL,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown sink type: %q", s.Type)
+	}
+}
@@ -0,0 +1,259 @@
+// Command fetcher scrapes Minerva's current status and schedule and fans
+// it out to one or more configured notification sinks.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/logging"
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+	"github.com/cybellereaper/fo76-minerva-fetcher/notify"
+	"github.com/cybellereaper/fo76-minerva-fetcher/state"
+)
+
+const (
+	maxRetries = 3
+	retryDelay = 5 * time.Second
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		runServe(os.Args[2:])
+		return
+	}
+	runOnce(os.Args[1:])
+}
+
+func runOnce(args []string) {
+	fs := flag.NewFlagSet("fetcher", flag.ExitOnError)
+	configPath := fs.String("config", "config.yaml", "path to the fetcher YAML config")
+	stateDir := fs.String("state-dir", ".", "directory holding the last-seen snapshot and rotation history")
+	historyAddr := fs.String("history-addr", "", "if set, serve the rotation history as JSON on this address (e.g. :8080) until the run completes")
+	logFormat := fs.String("log-format", "text", "log output format: json or text")
+	logLevel := fs.String("log-level", "info", "minimum log level: debug, info, warn, or error")
+	fs.Parse(args)
+
+	logger, err := logging.New(*logFormat, *logLevel)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to configure logger: %v\n", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		logger.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+
+	notifiers, err := buildNotifiers(cfg)
+	if err != nil {
+		logger.Error("failed to build notifiers", "error", err)
+		os.Exit(1)
+	}
+
+	store := state.NewStore(filepath.Join(*stateDir, "snapshot.json"), filepath.Join(*stateDir, "history.json"))
+	if *historyAddr != "" {
+		serveHistory(*historyAddr, store, logger)
+	}
+
+	ctx := context.Background()
+	data, err := fetchWithRetry(ctx, logger)
+	if err != nil || data == nil {
+		logger.Error("all retry attempts failed, setting GitHub Action failure status", "error", err)
+		os.Exit(1) // This will cause the GitHub Action to fail and retry
+	}
+
+	change, err := processFetch(ctx, notifiers, store, data, logger)
+	if err != nil {
+		logger.Error("failed to notify one or more sinks", "error", err)
+		os.Exit(1)
+	}
+	if !change.Changed() {
+		logger.Info("no change detected since the last run, skipping notification")
+	}
+
+	printJSON(data)
+}
+
+// processFetch runs the change-detection, notification, and persistence
+// pipeline shared by the one-shot and daemon entry points, so the two
+// can't drift from each other. It returns the detected change and any
+// error from notifying sinks of the regular status update; rotation and
+// persistence failures are logged internally rather than propagated, since
+// neither should block the other.
+func processFetch(ctx context.Context, notifiers []notify.Notifier, store *state.Store, data *minerva.MinervaData, logger *slog.Logger) (state.Change, error) {
+	previous, err := store.LoadSnapshot()
+	if err != nil {
+		logger.Warn("failed to load previous snapshot", "error", err)
+	}
+
+	change := state.Diff(previous, data)
+
+	var notifyErr error
+	if change.Changed() {
+		notifyErr = notifyAll(ctx, notifiers, data, logger)
+	}
+
+	if change.LocationChanged && previous != nil {
+		rotation := state.Rotation{From: previous.CurrentStatus.NextLocation, To: data.CurrentStatus.NextLocation, At: time.Now()}
+		if err := notifyRotation(ctx, notifiers, rotation, logger); err != nil {
+			logger.Error("failed to notify one or more sinks of the rotation", "error", err)
+		}
+		if err := store.AppendRotation(rotation); err != nil {
+			logger.Error("failed to record rotation history", "error", err)
+		}
+	}
+
+	if err := store.SaveSnapshot(data); err != nil {
+		logger.Error("failed to save snapshot", "error", err)
+	}
+
+	return change, notifyErr
+}
+
+// serveHistory mounts the store's history endpoint on a background HTTP
+// server that lives only as long as the one-shot run; the serve subcommand
+// mounts the same endpoint on its own server instead.
+func serveHistory(addr string, store *state.Store, logger *slog.Logger) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/history", store.HistoryHandler())
+
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			logger.Error("history server stopped", "error", err)
+		}
+	}()
+}
+
+// buildNotifiers constructs the notify.Notifier for every configured sink.
+func buildNotifiers(cfg *Config) ([]notify.Notifier, error) {
+	notifiers := make([]notify.Notifier, 0, len(cfg.Sinks))
+	for _, sink := range cfg.Sinks {
+		n, err := sink.Notifier()
+		if err != nil {
+			return nil, fmt.Errorf("sink %q: %w", sink.Type, err)
+		}
+		notifiers = append(notifiers, n)
+	}
+	return notifiers, nil
+}
+
+// buildProvider wires up the falloutbuilds.com scraper and two independent
+// JSON mirrors behind a MultiProvider. Three voters make majority
+// cross-validation meaningful: a DOM or schema change on any single source
+// is outvoted rather than deadlocking or silently publishing a wrong
+// location.
+func buildProvider(logger *slog.Logger) minerva.Provider {
+	scraper := minerva.NewScraper(minerva.ProxyConfigFromEnv(), logger)
+	nukacrypt := minerva.NewNukaCryptProvider("", logger)
+	nukesdragons := minerva.NewNukesDragonsProvider("", logger)
+	return minerva.NewMultiProvider(logger, scraper, nukacrypt, nukesdragons)
+}
+
+func fetchWithRetry(ctx context.Context, logger *slog.Logger) (*minerva.MinervaData, error) {
+	provider := buildProvider(logger)
+
+	var data *minerva.MinervaData
+	var err error
+
+	for i := 0; i < maxRetries; i++ {
+		data, err = provider.Fetch(ctx)
+		if err == nil && data != nil && len(data.SaleSchedule) > 0 {
+			return data, nil
+		}
+
+		logger.Warn("scrape attempt failed, retrying", "attempt", i+1, "error", err, "retry_after", retryDelay)
+		time.Sleep(retryDelay)
+	}
+
+	return nil, err
+}
+
+// notifyAll fans the scraped data out to every notifier concurrently,
+// retrying each one independently on failure.
+func notifyAll(ctx context.Context, notifiers []notify.Notifier, data *minerva.MinervaData, logger *slog.Logger) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(notifiers))
+
+	for i, n := range notifiers {
+		wg.Add(1)
+		go func(i int, n notify.Notifier) {
+			defer wg.Done()
+			errs[i] = notifyWithRetry(ctx, n, data, logger)
+		}(i, n)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("sink %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// notifyRotation pushes a rotation event to every notifier that implements
+// notify.RotationNotifier, skipping sinks that only support regular status
+// updates.
+func notifyRotation(ctx context.Context, notifiers []notify.Notifier, rotation state.Rotation, logger *slog.Logger) error {
+	var wg sync.WaitGroup
+	var errs []error
+	var mu sync.Mutex
+
+	for _, n := range notifiers {
+		rn, ok := n.(notify.RotationNotifier)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(rn notify.RotationNotifier) {
+			defer wg.Done()
+			if err := rn.NotifyRotation(ctx, rotation); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(rn)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		logger.Error("rotation notification failures", "count", len(errs), "error", errs[0])
+		return fmt.Errorf("%d rotation notifications failed: %v", len(errs), errs[0])
+	}
+	return nil
+}
+
+func notifyWithRetry(ctx context.Context, n notify.Notifier, data *minerva.MinervaData, logger *slog.Logger) error {
+	var err error
+	for i := 0; i < maxRetries; i++ {
+		err = n.Notify(ctx, data)
+		if err == nil {
+			return nil
+		}
+
+		logger.Warn("notify attempt failed, retrying", "attempt", i+1, "error", err, "retry_after", retryDelay)
+		time.Sleep(retryDelay)
+	}
+	return err
+}
+
+func printJSON(data *minerva.MinervaData) {
+	jsonData, err := json.MarshalIndent(data, "", "    ")
+	if err != nil {
+		slog.Default().Error("failed to marshal JSON", "error", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(jsonData))
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metrics holds the Prometheus collectors exposed by the daemon's /metrics
+// endpoint.
+type metrics struct {
+	registry         *prometheus.Registry
+	scrapeSuccesses  prometheus.Counter
+	scrapeFailures   prometheus.Counter
+	lastSuccess      prometheus.Gauge
+	consecutiveFails prometheus.Gauge
+}
+
+func newMetrics() *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		scrapeSuccesses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "minerva_scrape_success_total",
+			Help: "Total number of successful Minerva scrapes.",
+		}),
+		scrapeFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "minerva_scrape_failure_total",
+			Help: "Total number of failed Minerva scrape attempts.",
+		}),
+		lastSuccess: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "minerva_last_success_timestamp_seconds",
+			Help: "Unix timestamp of the last successful scrape.",
+		}),
+		consecutiveFails: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "minerva_consecutive_scrape_failures",
+			Help: "Number of scrape failures since the last success.",
+		}),
+	}
+
+	m.registry.MustRegister(m.scrapeSuccesses, m.scrapeFailures, m.lastSuccess, m.consecutiveFails)
+	return m
+}
+
+// healthzHandler reports liveness: the process is up and serving.
+func (m *metrics) healthzHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}
+}
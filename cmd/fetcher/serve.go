@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/logging"
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+	"github.com/cybellereaper/fo76-minerva-fetcher/notify"
+	"github.com/cybellereaper/fo76-minerva-fetcher/state"
+)
+
+const (
+	// defaultPollInterval is used when the scraped arrival time can't be
+	// parsed, falling back to a fixed cadence.
+	defaultPollInterval = 15 * time.Minute
+	// preArrivalLead is how far ahead of Minerva's arrival/departure we
+	// schedule the next scrape, to catch the rotation as it happens.
+	preArrivalLead  = 5 * time.Minute
+	minPollInterval = 1 * time.Minute
+
+	backoffBase = 5 * time.Second
+	backoffMax  = 5 * time.Minute
+)
+
+// runServe turns the one-shot scraper into a long-running process: it
+// scrapes on a schedule derived from Minerva's own countdown, serves
+// /healthz and /metrics, and shuts down gracefully on SIGINT/SIGTERM.
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	configPath := fs.String("config", "config.yaml", "path to the fetcher YAML config")
+	stateDir := fs.String("state-dir", ".", "directory holding the last-seen snapshot and rotation history")
+	addr := fs.String("addr", ":8080", "address to serve /healthz, /metrics, and /history on")
+	logFormat := fs.String("log-format", "text", "log output format: json or text")
+	logLevel := fs.String("log-level", "info", "minimum log level: debug, info, warn, or error")
+	fs.Parse(args)
+
+	logger, err := logging.New(*logFormat, *logLevel)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to configure logger: %v\n", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		logger.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+
+	notifiers, err := buildNotifiers(cfg)
+	if err != nil {
+		logger.Error("failed to build notifiers", "error", err)
+		os.Exit(1)
+	}
+
+	store := state.NewStore(filepath.Join(*stateDir, "snapshot.json"), filepath.Join(*stateDir, "history.json"))
+	m := newMetrics()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", m.healthzHandler())
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/history", store.HistoryHandler())
+
+	server := &http.Server{Addr: *addr, Handler: mux}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("http server stopped", "error", err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	pollLoop(ctx, notifiers, store, m, logger)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("failed to shut down http server cleanly", "error", err)
+	}
+}
+
+// pollLoop scrapes, notifies, and reschedules until ctx is cancelled.
+func pollLoop(ctx context.Context, notifiers []notify.Notifier, store *state.Store, m *metrics, logger *slog.Logger) {
+	provider := buildProvider(logger)
+	failures := 0
+
+	for {
+		data, err := provider.Fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			failures++
+			m.scrapeFailures.Inc()
+			m.consecutiveFails.Set(float64(failures))
+
+			delay := backoffDelay(failures)
+			logger.Warn("scrape failed, retrying", "attempt", failures, "error", err, "retry_after", delay)
+			if !sleepCtx(ctx, delay) {
+				return
+			}
+			continue
+		}
+
+		failures = 0
+		m.scrapeSuccesses.Inc()
+		m.consecutiveFails.Set(0)
+		m.lastSuccess.Set(float64(time.Now().Unix()))
+
+		next := handleScrape(ctx, notifiers, store, data, logger)
+
+		logger.Info("scheduled next scrape", "delay", next)
+		if !sleepCtx(ctx, next) {
+			return
+		}
+	}
+}
+
+// handleScrape runs the shared change-detection, notification, and
+// persistence pipeline for a single successful scrape, returning how long
+// to wait before the next one.
+func handleScrape(ctx context.Context, notifiers []notify.Notifier, store *state.Store, data *minerva.MinervaData, logger *slog.Logger) time.Duration {
+	if _, err := processFetch(ctx, notifiers, store, data, logger); err != nil {
+		logger.Error("failed to notify one or more sinks", "error", err)
+	}
+
+	return nextPollInterval(data)
+}
+
+// nextPollInterval schedules the next scrape a few minutes ahead of
+// Minerva's arrival/departure. It falls back to defaultPollInterval when the
+// countdown can't be parsed, and waits minPollInterval when the arrival is
+// too close or has already passed.
+func nextPollInterval(data *minerva.MinervaData) time.Duration {
+	arrival, err := data.CurrentStatus.Arrival()
+	if err != nil {
+		return defaultPollInterval
+	}
+
+	until := time.Until(arrival) - preArrivalLead
+	if until < minPollInterval {
+		return minPollInterval
+	}
+	return until
+}
+
+// backoffDelay returns a jittered exponential backoff for the given
+// (1-indexed) consecutive failure count.
+func backoffDelay(failures int) time.Duration {
+	d := backoffBase * time.Duration(1<<uint(failures))
+	if d <= 0 || d > backoffMax {
+		d = backoffMax
+	}
+	return time.Duration(rand.Int63n(int64(d)))
+}
+
+// sleepCtx waits for d or until ctx is cancelled, reporting which one
+// happened first.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
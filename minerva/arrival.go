@@ -0,0 +1,44 @@
+package minerva
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// arrivalTimeLayouts are the timestamp formats observed in the
+// data-minervacountdown attribute, tried in order.
+var arrivalTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+}
+
+// ParseArrivalTime parses the raw data-minervacountdown attribute value
+// into a time.Time. It accepts a Unix timestamp (seconds or milliseconds)
+// or one of arrivalTimeLayouts.
+func ParseArrivalTime(raw string) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, fmt.Errorf("empty arrival time")
+	}
+
+	if unix, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		if unix > 1e12 { // milliseconds
+			return time.UnixMilli(unix), nil
+		}
+		return time.Unix(unix, 0), nil
+	}
+
+	for _, layout := range arrivalTimeLayouts {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized arrival time format: %q", raw)
+}
+
+// Arrival parses this status's ArrivalTime into a time.Time.
+func (c CurrentStatus) Arrival() (time.Time, error) {
+	return ParseArrivalTime(c.ArrivalTime)
+}
@@ -0,0 +1,56 @@
+package minerva
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseArrivalTimeUnixSeconds(t *testing.T) {
+	got, err := ParseArrivalTime("1700000000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Unix(1700000000, 0); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParseArrivalTimeUnixMillis(t *testing.T) {
+	got, err := ParseArrivalTime("1700000000000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.UnixMilli(1700000000000); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParseArrivalTimeRFC3339(t *testing.T) {
+	got, err := ParseArrivalTime("2026-07-26T12:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 7, 26, 12, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParseArrivalTimeCustomLayout(t *testing.T) {
+	got, err := ParseArrivalTime("2026-07-26 12:00:00")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := time.Date(2026, 7, 26, 12, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParseArrivalTimeInvalid(t *testing.T) {
+	if _, err := ParseArrivalTime(""); err == nil {
+		t.Error("expected an empty string to be rejected")
+	}
+	if _, err := ParseArrivalTime("not a time"); err == nil {
+		t.Error("expected an unrecognized format to be rejected")
+	}
+}
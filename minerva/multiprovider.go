@@ -0,0 +1,147 @@
+package minerva
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+)
+
+// MultiProvider fetches from several Providers in parallel and only
+// publishes a result once it is backed by a strict majority of the total
+// vote weight cast that round, so a lone dissenter can never block
+// consensus and a tie can never be published. A provider that disagrees
+// with the winning result is demoted: its vote weight decays
+// exponentially with each consecutive disagreement, so a source whose DOM
+// or schema has drifted keeps losing influence over future rounds instead
+// of being able to deadlock them indefinitely.
+type MultiProvider struct {
+	providers []Provider
+	logger    *slog.Logger
+
+	mu          sync.Mutex
+	disagreeing map[string]int // consecutive disagreements per provider name
+}
+
+// NewMultiProvider builds a MultiProvider over providers. A nil logger
+// falls back to slog.Default().
+func NewMultiProvider(logger *slog.Logger, providers ...Provider) *MultiProvider {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &MultiProvider{
+		providers:   providers,
+		logger:      logger,
+		disagreeing: make(map[string]int),
+	}
+}
+
+// Name identifies this Provider in logs and MultiProvider diagnostics.
+func (m *MultiProvider) Name() string {
+	return "multi"
+}
+
+type providerResult struct {
+	provider Provider
+	data     *MinervaData
+	err      error
+}
+
+// minQuorum is the minimum number of providers that must actually return
+// data before a result can be published, regardless of vote weight. It
+// stops a single surviving provider (everyone else errored out) from being
+// published unchecked, which would defeat the whole point of cross-validation.
+const minQuorum = 2
+
+// Fetch runs every provider concurrently and returns the data agreed on by
+// the highest-weighted location, provided at least minQuorum providers
+// responded and the winning location holds a strict majority of the
+// weight cast.
+func (m *MultiProvider) Fetch(ctx context.Context) (*MinervaData, error) {
+	results := make([]providerResult, len(m.providers))
+
+	var wg sync.WaitGroup
+	for i, p := range m.providers {
+		wg.Add(1)
+		go func(i int, p Provider) {
+			defer wg.Done()
+			data, err := p.Fetch(ctx)
+			results[i] = providerResult{provider: p, data: data, err: err}
+		}(i, p)
+	}
+	wg.Wait()
+
+	weightByLocation := make(map[string]float64)
+	dataByLocation := make(map[string]*MinervaData)
+	votesByLocation := make(map[string]int)
+	totalWeight := 0.0
+	successes := 0
+
+	for _, r := range results {
+		if r.err != nil || r.data == nil {
+			m.logger.Warn("provider fetch failed", "provider", r.provider.Name(), "error", r.err)
+			continue
+		}
+
+		loc := r.data.CurrentStatus.NextLocation
+		weight := m.weight(r.provider.Name())
+		weightByLocation[loc] += weight
+		dataByLocation[loc] = r.data
+		votesByLocation[loc]++
+		totalWeight += weight
+		successes++
+	}
+
+	if successes < minQuorum {
+		return nil, fmt.Errorf("only %d of %d providers responded, need at least %d for cross-validation", successes, len(m.providers), minQuorum)
+	}
+
+	winner, winnerWeight := "", 0.0
+	for loc, weight := range weightByLocation {
+		if weight > winnerWeight {
+			winner, winnerWeight = loc, weight
+		}
+	}
+
+	if winnerWeight <= totalWeight/2 {
+		return nil, fmt.Errorf("no location has a majority of provider votes (votes: %v)", weightByLocation)
+	}
+
+	if votesByLocation[winner] < minQuorum {
+		return nil, fmt.Errorf("next location %q was only confirmed by %d provider(s), need at least %d", winner, votesByLocation[winner], minQuorum)
+	}
+
+	for _, r := range results {
+		if r.err != nil || r.data == nil {
+			continue
+		}
+		if r.data.CurrentStatus.NextLocation == winner {
+			m.resetPenalty(r.provider.Name())
+		} else {
+			m.penalize(r.provider.Name())
+		}
+	}
+
+	return dataByLocation[winner], nil
+}
+
+// weight returns a provider's current vote weight: 1 for a provider with
+// no recent disagreements, halving for every consecutive disagreement.
+func (m *MultiProvider) weight(name string) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return 1 / math.Pow(2, float64(m.disagreeing[name]))
+}
+
+func (m *MultiProvider) penalize(name string) {
+	m.mu.Lock()
+	m.disagreeing[name]++
+	m.mu.Unlock()
+}
+
+func (m *MultiProvider) resetPenalty(name string) {
+	m.mu.Lock()
+	m.disagreeing[name] = 0
+	m.mu.Unlock()
+}
@@ -0,0 +1,110 @@
+package minerva
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type fakeProvider struct {
+	name string
+	data *MinervaData
+	err  error
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) Fetch(ctx context.Context) (*MinervaData, error) {
+	return p.data, p.err
+}
+
+func dataFor(location string) *MinervaData {
+	return &MinervaData{CurrentStatus: CurrentStatus{NextLocation: location}}
+}
+
+func TestMultiProviderUnanimous(t *testing.T) {
+	mp := NewMultiProvider(nil,
+		&fakeProvider{name: "a", data: dataFor("Vault 76")},
+		&fakeProvider{name: "b", data: dataFor("Vault 76")},
+		&fakeProvider{name: "c", data: dataFor("Vault 76")},
+	)
+
+	data, err := mp.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.CurrentStatus.NextLocation != "Vault 76" {
+		t.Errorf("got location %q, want Vault 76", data.CurrentStatus.NextLocation)
+	}
+}
+
+func TestMultiProviderOutvotesDissenter(t *testing.T) {
+	mp := NewMultiProvider(nil,
+		&fakeProvider{name: "a", data: dataFor("Vault 76")},
+		&fakeProvider{name: "b", data: dataFor("Vault 76")},
+		&fakeProvider{name: "c", data: dataFor("Whitespring")},
+	)
+
+	data, err := mp.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.CurrentStatus.NextLocation != "Vault 76" {
+		t.Errorf("got location %q, want Vault 76", data.CurrentStatus.NextLocation)
+	}
+}
+
+func TestMultiProviderTieFails(t *testing.T) {
+	mp := NewMultiProvider(nil,
+		&fakeProvider{name: "a", data: dataFor("Vault 76")},
+		&fakeProvider{name: "b", data: dataFor("Whitespring")},
+	)
+
+	if _, err := mp.Fetch(context.Background()); err == nil {
+		t.Fatal("expected a tie between two equally-weighted providers to fail rather than guess")
+	}
+}
+
+func TestMultiProviderAllFail(t *testing.T) {
+	mp := NewMultiProvider(nil,
+		&fakeProvider{name: "a", err: fmt.Errorf("boom")},
+		&fakeProvider{name: "b", err: fmt.Errorf("boom")},
+	)
+
+	if _, err := mp.Fetch(context.Background()); err == nil {
+		t.Fatal("expected an error when every provider fails")
+	}
+}
+
+func TestMultiProviderRequiresQuorumNotJustAMajority(t *testing.T) {
+	mp := NewMultiProvider(nil,
+		&fakeProvider{name: "a", data: dataFor("Vault 76")},
+		&fakeProvider{name: "b", err: fmt.Errorf("404")},
+		&fakeProvider{name: "c", err: fmt.Errorf("404")},
+	)
+
+	if _, err := mp.Fetch(context.Background()); err == nil {
+		t.Fatal("expected a lone surviving provider to fail quorum rather than publish unvalidated")
+	}
+}
+
+func TestMultiProviderPenalizesRepeatedDissent(t *testing.T) {
+	mp := NewMultiProvider(nil,
+		&fakeProvider{name: "a", data: dataFor("Vault 76")},
+		&fakeProvider{name: "b", data: dataFor("Vault 76")},
+		&fakeProvider{name: "c", data: dataFor("Whitespring")},
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := mp.Fetch(context.Background()); err != nil {
+			t.Fatalf("round %d: unexpected error: %v", i, err)
+		}
+	}
+
+	if got, want := mp.weight("c"), 0.125; got != want {
+		t.Errorf("after 3 consecutive disagreements, weight(c) = %v, want %v", got, want)
+	}
+	if got, want := mp.weight("a"), 1.0; got != want {
+		t.Errorf("weight(a) = %v, want %v for a provider that keeps agreeing", got, want)
+	}
+}
@@ -0,0 +1,11 @@
+package minerva
+
+import "context"
+
+// Provider fetches a MinervaData snapshot from a single source.
+type Provider interface {
+	// Fetch performs one fetch attempt, returning the parsed MinervaData.
+	Fetch(ctx context.Context) (*MinervaData, error)
+	// Name identifies the provider in logs and MultiProvider diagnostics.
+	Name() string
+}
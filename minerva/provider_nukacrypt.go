@@ -0,0 +1,69 @@
+package minerva
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// defaultNukaCryptURL is a community-maintained JSON mirror of Minerva's
+// schedule, used to cross-validate the falloutbuilds.com HTML scrape.
+const defaultNukaCryptURL = "https://nukacrypt.com/minerva/schedule.json"
+
+// NukaCryptProvider fetches MinervaData from a community-maintained JSON
+// endpoint, shaped the same as MinervaData. It implements Provider.
+type NukaCryptProvider struct {
+	url    string
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewNukaCryptProvider builds a NukaCryptProvider against url. An empty
+// url falls back to defaultNukaCryptURL. A nil logger falls back to
+// slog.Default().
+func NewNukaCryptProvider(url string, logger *slog.Logger) *NukaCryptProvider {
+	if url == "" {
+		url = defaultNukaCryptURL
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &NukaCryptProvider{url: url, client: http.DefaultClient, logger: logger}
+}
+
+// Name identifies this Provider in logs and MultiProvider diagnostics.
+func (p *NukaCryptProvider) Name() string {
+	return "nukacrypt"
+}
+
+// Fetch retrieves and decodes the JSON schedule.
+func (p *NukaCryptProvider) Fetch(ctx context.Context) (*MinervaData, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", p.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch nukacrypt schedule: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("nukacrypt schedule returned status code: %d", resp.StatusCode)
+	}
+
+	var data MinervaData
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode nukacrypt schedule: %w", err)
+	}
+
+	if data.CurrentStatus.NextLocation == "" || len(data.SaleSchedule) == 0 {
+		return nil, fmt.Errorf("nukacrypt schedule missing required data")
+	}
+
+	p.logger.Debug("fetched nukacrypt schedule", "next_location", data.CurrentStatus.NextLocation)
+	return &data, nil
+}
@@ -0,0 +1,70 @@
+package minerva
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// defaultNukesDragonsURL is a second community-maintained JSON mirror of
+// Minerva's schedule, used alongside NukaCryptProvider so MultiProvider has
+// a third, independent vote to break a falloutbuilds/nukacrypt tie.
+const defaultNukesDragonsURL = "https://nukesdragons.com/minerva/schedule.json"
+
+// NukesDragonsProvider fetches MinervaData from a community-maintained JSON
+// endpoint shaped the same as MinervaData. It implements Provider.
+type NukesDragonsProvider struct {
+	url    string
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewNukesDragonsProvider builds a NukesDragonsProvider against url. An
+// empty url falls back to defaultNukesDragonsURL. A nil logger falls back
+// to slog.Default().
+func NewNukesDragonsProvider(url string, logger *slog.Logger) *NukesDragonsProvider {
+	if url == "" {
+		url = defaultNukesDragonsURL
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &NukesDragonsProvider{url: url, client: http.DefaultClient, logger: logger}
+}
+
+// Name identifies this Provider in logs and MultiProvider diagnostics.
+func (p *NukesDragonsProvider) Name() string {
+	return "nukesdragons"
+}
+
+// Fetch retrieves and decodes the JSON schedule.
+func (p *NukesDragonsProvider) Fetch(ctx context.Context) (*MinervaData, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", p.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch nukesdragons schedule: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("nukesdragons schedule returned status code: %d", resp.StatusCode)
+	}
+
+	var data MinervaData
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode nukesdragons schedule: %w", err)
+	}
+
+	if data.CurrentStatus.NextLocation == "" || len(data.SaleSchedule) == 0 {
+		return nil, fmt.Errorf("nukesdragons schedule missing required data")
+	}
+
+	p.logger.Debug("fetched nukesdragons schedule", "next_location", data.CurrentStatus.NextLocation)
+	return &data, nil
+}
@@ -0,0 +1,244 @@
+package minerva
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/proxy"
+)
+
+// ProxyMode selects how a ProxyEndpoint is dialed.
+type ProxyMode string
+
+const (
+	ProxyModeSOCKS5 ProxyMode = "socks5"
+	ProxyModeHTTP   ProxyMode = "http"
+)
+
+// ProxyEndpoint is a single upstream proxy, dialed according to Mode.
+type ProxyEndpoint struct {
+	Mode ProxyMode
+	Addr string // host:port
+}
+
+// ProxyConfig controls how the Scraper's collector reaches the network:
+// zero or more proxies tried in round-robin order with failover, an
+// automatic fallback to a direct connection once MaxFailures consecutive
+// proxy dials fail, and a pool of User-Agent strings rotated per request.
+type ProxyConfig struct {
+	Endpoints   []ProxyEndpoint
+	MaxFailures int
+	UserAgents  []string
+}
+
+const (
+	envProxyAddrs       = "MINERVA_PROXY_ADDRS"
+	envProxyMaxFailures = "MINERVA_PROXY_MAX_FAILURES"
+	envUserAgents       = "MINERVA_USER_AGENTS"
+
+	defaultUserAgent   = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36"
+	defaultMaxFailures = 3
+)
+
+// ProxyConfigFromEnv builds a ProxyConfig from the environment:
+//
+//   - MINERVA_PROXY_ADDRS: comma-separated list of scheme-prefixed proxy
+//     addresses, e.g. "socks5://localhost:9050,http://10.0.0.1:8080".
+//     Empty or unset means direct connections only.
+//   - MINERVA_PROXY_MAX_FAILURES: consecutive proxy dial failures allowed
+//     before falling back to a direct connection. Defaults to 3.
+//   - MINERVA_USER_AGENTS: comma-separated list of User-Agent strings to
+//     rotate through per request. Defaults to a single desktop Chrome UA.
+func ProxyConfigFromEnv() ProxyConfig {
+	cfg := ProxyConfig{MaxFailures: defaultMaxFailures, UserAgents: []string{defaultUserAgent}}
+
+	if raw := os.Getenv(envProxyAddrs); raw != "" {
+		cfg.Endpoints = parseProxyAddrs(raw)
+	}
+
+	if raw := os.Getenv(envProxyMaxFailures); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			cfg.MaxFailures = n
+		}
+	}
+
+	if raw := os.Getenv(envUserAgents); raw != "" {
+		cfg.UserAgents = splitAndTrim(raw)
+	}
+
+	return cfg
+}
+
+func parseProxyAddrs(raw string) []ProxyEndpoint {
+	var endpoints []ProxyEndpoint
+	for _, entry := range splitAndTrim(raw) {
+		u, err := url.Parse(entry)
+		if err != nil || u.Host == "" {
+			continue
+		}
+
+		switch u.Scheme {
+		case "socks5":
+			endpoints = append(endpoints, ProxyEndpoint{Mode: ProxyModeSOCKS5, Addr: u.Host})
+		case "http", "https":
+			endpoints = append(endpoints, ProxyEndpoint{Mode: ProxyModeHTTP, Addr: u.Host})
+		}
+	}
+	return endpoints
+}
+
+func splitAndTrim(raw string) []string {
+	var out []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// proxyDialer round-robins across a ProxyConfig's endpoints, falling back
+// to a direct connection once MaxFailures consecutive dials fail.
+type proxyDialer struct {
+	mu        sync.Mutex
+	endpoints []ProxyEndpoint
+	next      int
+	failures  int
+	cfg       ProxyConfig
+}
+
+func newProxyDialer(cfg ProxyConfig) *proxyDialer {
+	return &proxyDialer{endpoints: cfg.Endpoints, cfg: cfg}
+}
+
+// Dial implements the signature expected by http.Transport.Dial.
+func (d *proxyDialer) Dial(network, addr string) (net.Conn, error) {
+	for {
+		ep, ok := d.pick()
+		if !ok {
+			return net.Dial(network, addr)
+		}
+
+		dialer, err := dialerFor(ep)
+		if err != nil {
+			d.recordFailure()
+			continue
+		}
+
+		conn, err := dialer.Dial(network, addr)
+		if err != nil {
+			d.recordFailure()
+			continue
+		}
+
+		d.recordSuccess()
+		return conn, nil
+	}
+}
+
+func (d *proxyDialer) pick() (ProxyEndpoint, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	maxFailures := d.cfg.MaxFailures
+	if maxFailures <= 0 {
+		maxFailures = defaultMaxFailures
+	}
+
+	if len(d.endpoints) == 0 || d.failures >= maxFailures {
+		return ProxyEndpoint{}, false
+	}
+
+	ep := d.endpoints[d.next]
+	d.next = (d.next + 1) % len(d.endpoints)
+	return ep, true
+}
+
+func (d *proxyDialer) recordFailure() {
+	d.mu.Lock()
+	d.failures++
+	d.mu.Unlock()
+}
+
+func (d *proxyDialer) recordSuccess() {
+	d.mu.Lock()
+	d.failures = 0
+	d.mu.Unlock()
+}
+
+func dialerFor(ep ProxyEndpoint) (proxy.Dialer, error) {
+	switch ep.Mode {
+	case ProxyModeSOCKS5:
+		return proxy.SOCKS5("tcp", ep.Addr, nil, proxy.Direct)
+	case ProxyModeHTTP:
+		return &httpConnectDialer{proxyAddr: ep.Addr}, nil
+	default:
+		return nil, fmt.Errorf("unknown proxy mode: %q", ep.Mode)
+	}
+}
+
+// httpConnectDialer tunnels a connection through an HTTP proxy via CONNECT.
+type httpConnectDialer struct {
+	proxyAddr string
+}
+
+func (d *httpConnectDialer) Dial(network, addr string) (net.Conn, error) {
+	conn, err := net.Dial("tcp", d.proxyAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial http proxy: %w", err)
+	}
+
+	req := &http.Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to write CONNECT request: %w", err)
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read CONNECT response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy CONNECT failed: %s", resp.Status)
+	}
+
+	return conn, nil
+}
+
+// userAgentRotator cycles through a pool of User-Agent strings, one per
+// request.
+type userAgentRotator struct {
+	mu     sync.Mutex
+	agents []string
+	next   int
+}
+
+func newUserAgentRotator(agents []string) *userAgentRotator {
+	if len(agents) == 0 {
+		agents = []string{defaultUserAgent}
+	}
+	return &userAgentRotator{agents: agents}
+}
+
+func (r *userAgentRotator) Next() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ua := r.agents[r.next]
+	r.next = (r.next + 1) % len(r.agents)
+	return ua
+}
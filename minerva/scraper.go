@@ -0,0 +1,133 @@
+package minerva
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gocolly/colly"
+)
+
+const scrapeURL = "https://www.falloutbuilds.com/fo76/minerva/"
+
+// Scraper fetches the current MinervaData from falloutbuilds.com. It
+// implements Provider.
+type Scraper struct {
+	proxyConfig ProxyConfig
+	logger      *slog.Logger
+}
+
+// NewScraper builds a Scraper that routes through proxyConfig's endpoints
+// (falling back to a direct connection per its rules). The underlying
+// collector is built fresh on every Fetch call rather than cached, so a
+// long-running daemon that keeps one Scraper around forever doesn't pile
+// up OnHTML/OnError handlers on a single colly.Collector. A bad proxy
+// configuration never aborts the process: unparseable proxy addresses are
+// skipped, and failed proxy dials count toward the fallback to a direct
+// connection. A nil logger falls back to slog.Default().
+func NewScraper(proxyConfig ProxyConfig, logger *slog.Logger) *Scraper {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Scraper{proxyConfig: proxyConfig, logger: logger}
+}
+
+// Name identifies this Provider in logs and MultiProvider diagnostics.
+func (s *Scraper) Name() string {
+	return "falloutbuilds"
+}
+
+// Fetch performs a single scrape attempt and returns the parsed MinervaData.
+// ctx is checked before the request is made, so a daemon can skip a scrape
+// that's already been cancelled (e.g. on shutdown).
+func (s *Scraper) Fetch(ctx context.Context) (*MinervaData, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	c, err := setupCollector(s.proxyConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build collector: %w", err)
+	}
+
+	data := &MinervaData{
+		SaleSchedule: make([]SaleInfo, 0),
+	}
+
+	setupHandlers(c, data, s.logger)
+
+	s.logger.Debug("visiting Minerva schedule page", "url", scrapeURL)
+	if err := c.Visit(scrapeURL); err != nil {
+		return nil, fmt.Errorf("failed to visit URL: %w", err)
+	}
+
+	if data.CurrentStatus.NextLocation == "" || len(data.SaleSchedule) == 0 {
+		return nil, fmt.Errorf("failed to scrape required data")
+	}
+
+	return data, nil
+}
+
+func setupCollector(cfg ProxyConfig) (*colly.Collector, error) {
+	dialer := newProxyDialer(cfg)
+	uaRotator := newUserAgentRotator(cfg.UserAgents)
+
+	transport := &http.Transport{
+		Dial: dialer.Dial,
+	}
+
+	c := colly.NewCollector(colly.AllowURLRevisit())
+	c.WithTransport(transport)
+	c.SetRequestTimeout(30 * time.Second)
+
+	c.OnRequest(func(r *colly.Request) {
+		r.Headers.Set("User-Agent", uaRotator.Next())
+	})
+
+	return c, nil
+}
+
+func setupHandlers(c *colly.Collector, data *MinervaData, logger *slog.Logger) {
+	c.OnHTML("div.p-3", extractCurrentStatus(data))
+	c.OnHTML("figure.is-style-stripes table tbody tr", extractSaleInfo(data, logger))
+	c.OnError(handleError(logger))
+}
+
+func extractCurrentStatus(data *MinervaData) func(*colly.HTMLElement) {
+	return func(e *colly.HTMLElement) {
+		data.CurrentStatus = CurrentStatus{
+			NextLocation: strings.TrimSpace(e.ChildText("strong.text-lightgreen")),
+			ArrivalTime:  e.ChildAttr("div[data-minervacountdown]", "data-minervacountdown"),
+		}
+	}
+}
+
+func extractSaleInfo(data *MinervaData, logger *slog.Logger) func(*colly.HTMLElement) {
+	return func(e *colly.HTMLElement) {
+		sale := e.ChildText("td:nth-child(1)")
+		location := strings.TrimSpace(strings.Split(e.ChildText("td:nth-child(2)"), "Next")[0])
+		startDate := e.ChildText("td:nth-child(3)")
+		endDate := e.ChildText("td:nth-child(4)")
+
+		if sale != "" && location != "" && startDate != "" && endDate != "" {
+			saleInfo := SaleInfo{
+				SaleNumber: strings.TrimSpace(sale),
+				Location:   location,
+				StartDate:  strings.TrimSpace(startDate),
+				EndDate:    strings.TrimSpace(endDate),
+				IsNext:     e.DOM.HasClass("bg-dark"),
+			}
+			logger.Debug("parsed sale row", "sale_number", saleInfo.SaleNumber, "location", saleInfo.Location)
+			data.SaleSchedule = append(data.SaleSchedule, saleInfo)
+		}
+	}
+}
+
+func handleError(logger *slog.Logger) func(*colly.Response, error) {
+	return func(r *colly.Response, err error) {
+		logger.Error("scrape request failed", "url", r.Request.URL, "error", err)
+	}
+}
@@ -0,0 +1,24 @@
+// Package minerva scrapes and models Fallout 76's Minerva vendor schedule.
+package minerva
+
+// MinervaData is the full snapshot of Minerva's current whereabouts and
+// upcoming sale schedule produced by a Scraper.
+type MinervaData struct {
+	CurrentStatus CurrentStatus `json:"current_status"`
+	SaleSchedule  []SaleInfo    `json:"sale_schedule"`
+}
+
+// CurrentStatus describes where Minerva is headed next and when.
+type CurrentStatus struct {
+	NextLocation string `json:"next_location"`
+	ArrivalTime  string `json:"arrival_time"`
+}
+
+// SaleInfo is a single row of Minerva's sale schedule.
+type SaleInfo struct {
+	SaleNumber string `json:"sale_number"`
+	Location   string `json:"location"`
+	StartDate  string `json:"start_date"`
+	EndDate    string `json:"end_date"`
+	IsNext     bool   `json:"is_next"`
+}
@@ -0,0 +1,145 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+// ActivityPubNotifier delivers a "Create Note" activity to a configured
+// actor's shared inbox, signed with HTTP Signatures as required by
+// Mastodon-compatible servers. Delivering to the shared inbox rather than
+// crawling the followers collection keeps a single signed POST per update.
+type ActivityPubNotifier struct {
+	ActorID        string // e.g. https://example.social/users/minerva
+	KeyID          string // e.g. https://example.social/users/minerva#main-key
+	PrivateKeyPEM  []byte
+	SharedInboxURL string
+}
+
+// Notify builds a Create(Note) activity describing the current status and
+// POSTs it, signed, to SharedInboxURL.
+func (n *ActivityPubNotifier) Notify(ctx context.Context, data *minerva.MinervaData) error {
+	if n.ActorID == "" || n.SharedInboxURL == "" {
+		return fmt.Errorf("activitypub actor ID and shared inbox URL must be set")
+	}
+
+	key, err := parseRSAPrivateKey(n.PrivateKeyPEM)
+	if err != nil {
+		return fmt.Errorf("failed to parse activitypub private key: %w", err)
+	}
+
+	content := fmt.Sprintf("Minerva is heading to %s (%s)", data.CurrentStatus.NextLocation, data.CurrentStatus.ArrivalTime)
+	note := map[string]interface{}{
+		"type":         "Note",
+		"attributedTo": n.ActorID,
+		"content":      content,
+		"to":           []string{n.ActorID + "/followers"},
+	}
+	activity := map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"type":     "Create",
+		"actor":    n.ActorID,
+		"object":   note,
+		"to":       []string{n.ActorID + "/followers"},
+	}
+
+	payloadBytes, err := json.Marshal(activity)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", n.SharedInboxURL, bytes.NewReader(payloadBytes))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/activity+json")
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+
+	digest := sha256.Sum256(payloadBytes)
+	req.Header.Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(digest[:]))
+
+	if err := signRequest(req, n.KeyID, key); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("activitypub inbox returned status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func parseRSAPrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("key is not an RSA private key")
+	}
+	return rsaKey, nil
+}
+
+// signRequest adds a draft-cavage HTTP Signature over the (request-target),
+// host, date, and digest headers, as required by Mastodon-style inboxes.
+func signRequest(req *http.Request, keyID string, key *rsa.PrivateKey) error {
+	headers := []string{"(request-target)", "host", "date", "digest"}
+	requestTarget := fmt.Sprintf("%s %s", strings.ToLower(req.Method), req.URL.RequestURI())
+
+	var signingLines []string
+	for _, h := range headers {
+		switch h {
+		case "(request-target)":
+			signingLines = append(signingLines, "(request-target): "+requestTarget)
+		case "host":
+			signingLines = append(signingLines, "host: "+req.URL.Host)
+		default:
+			signingLines = append(signingLines, h+": "+req.Header.Get(h))
+		}
+	}
+	signingString := strings.Join(signingLines, "\n")
+
+	hashed := sha256.Sum256([]byte(signingString))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("Signature", fmt.Sprintf(
+		`keyId="%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		keyID, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(signature),
+	))
+
+	return nil
+}
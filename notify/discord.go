@@ -0,0 +1,98 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+	"github.com/cybellereaper/fo76-minerva-fetcher/state"
+)
+
+// DiscordNotifier posts a rich embed to a Discord webhook.
+type DiscordNotifier struct {
+	WebhookURL string
+}
+
+// Notify sends data as a Discord embed via the configured webhook URL.
+func (n *DiscordNotifier) Notify(ctx context.Context, data *minerva.MinervaData) error {
+	if n.WebhookURL == "" {
+		return fmt.Errorf("discord webhook URL is not set")
+	}
+
+	embed := map[string]interface{}{
+		"title":       "Minerva's Current Status",
+		"description": fmt.Sprintf("**Location:** %s\n**Arrival Time:** %s\n", data.CurrentStatus.NextLocation, data.CurrentStatus.ArrivalTime),
+		"color":       3066993, // Green color (#2ECC71) for the embed
+		"fields": []map[string]interface{}{
+			{
+				"name":   "Upcoming Sale Schedule",
+				"value":  "Below is the schedule of upcoming sales.",
+				"inline": false,
+			},
+		},
+	}
+
+	for _, sale := range data.SaleSchedule {
+		embed["fields"] = append(embed["fields"].([]map[string]interface{}), map[string]interface{}{
+			"name":   fmt.Sprintf("Sale %s", sale.SaleNumber),
+			"value":  fmt.Sprintf("%s at %s: %s to %s", sale.SaleNumber, sale.Location, sale.StartDate, sale.EndDate),
+			"inline": false,
+		})
+	}
+
+	return n.postEmbed(ctx, embed)
+}
+
+// NotifyRotation posts a distinct embed announcing that Minerva has moved
+// to a new location, rather than the regular status update.
+func (n *DiscordNotifier) NotifyRotation(ctx context.Context, rotation state.Rotation) error {
+	if n.WebhookURL == "" {
+		return fmt.Errorf("discord webhook URL is not set")
+	}
+
+	embed := map[string]interface{}{
+		"title":       "Minerva Has Rotated!",
+		"description": fmt.Sprintf("Minerva left **%s** and is now heading to **%s**.", rotation.From, rotation.To),
+		"color":       15105570, // Orange color for the rotation embed
+	}
+
+	return n.postEmbed(ctx, embed)
+}
+
+func (n *DiscordNotifier) postEmbed(ctx context.Context, embed map[string]interface{}) error {
+	payload := map[string]interface{}{
+		"embeds": []interface{}{embed},
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", n.WebhookURL, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to send message to Discord, status code: %d, response: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+	"github.com/cybellereaper/fo76-minerva-fetcher/state"
+)
+
+func TestDiscordNotifierMissingWebhookURL(t *testing.T) {
+	n := &DiscordNotifier{}
+	if err := n.Notify(context.Background(), &minerva.MinervaData{}); err == nil {
+		t.Fatal("expected an error when WebhookURL is empty")
+	}
+}
+
+func TestDiscordNotifierNotify(t *testing.T) {
+	var gotPayload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("got Content-Type %q, want application/json", ct)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&gotPayload); err != nil {
+			t.Errorf("failed to decode payload: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	n := &DiscordNotifier{WebhookURL: server.URL}
+	data := &minerva.MinervaData{
+		CurrentStatus: minerva.CurrentStatus{NextLocation: "Vault 76", ArrivalTime: "2026-07-26T12:00:00Z"},
+		SaleSchedule:  []minerva.SaleInfo{{SaleNumber: "1", Location: "Vault 76", StartDate: "2026-07-26", EndDate: "2026-07-27"}},
+	}
+
+	if err := n.Notify(context.Background(), data); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	embeds, ok := gotPayload["embeds"].([]interface{})
+	if !ok || len(embeds) != 1 {
+		t.Fatalf("got embeds %+v, want exactly one embed", gotPayload["embeds"])
+	}
+	embed := embeds[0].(map[string]interface{})
+	if embed["title"] != "Minerva's Current Status" {
+		t.Errorf("got title %v, want %q", embed["title"], "Minerva's Current Status")
+	}
+	fields, ok := embed["fields"].([]interface{})
+	if !ok || len(fields) != 2 {
+		t.Fatalf("got %d fields, want 2 (the header field plus one sale row)", len(fields))
+	}
+}
+
+func TestDiscordNotifierNotifyRotation(t *testing.T) {
+	var gotPayload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&gotPayload)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	n := &DiscordNotifier{WebhookURL: server.URL}
+	rotation := state.Rotation{From: "Vault 76", To: "Whitespring"}
+
+	if err := n.NotifyRotation(context.Background(), rotation); err != nil {
+		t.Fatalf("NotifyRotation: %v", err)
+	}
+
+	embeds := gotPayload["embeds"].([]interface{})
+	embed := embeds[0].(map[string]interface{})
+	if embed["title"] != "Minerva Has Rotated!" {
+		t.Errorf("got title %v, want %q", embed["title"], "Minerva Has Rotated!")
+	}
+}
+
+func TestDiscordNotifierNotifyErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n := &DiscordNotifier{WebhookURL: server.URL}
+	if err := n.Notify(context.Background(), &minerva.MinervaData{}); err == nil {
+		t.Fatal("expected an error for a non-200 response")
+	}
+}
@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+// MatrixNotifier sends an m.room.message event to a Matrix room via the
+// client-server API.
+type MatrixNotifier struct {
+	HomeserverURL string
+	RoomID        string
+	AccessToken   string
+}
+
+// Notify sends data as a formatted m.room.message event.
+func (n *MatrixNotifier) Notify(ctx context.Context, data *minerva.MinervaData) error {
+	if n.HomeserverURL == "" || n.RoomID == "" || n.AccessToken == "" {
+		return fmt.Errorf("matrix homeserver URL, room ID, and access token must be set")
+	}
+
+	body := fmt.Sprintf("Minerva is heading to %s (%s)", data.CurrentStatus.NextLocation, data.CurrentStatus.ArrivalTime)
+	event := map[string]interface{}{
+		"msgtype": "m.text",
+		"body":    body,
+	}
+
+	payloadBytes, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	txnID := strconv.FormatInt(time.Now().UnixNano(), 10)
+	url := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s", n.HomeserverURL, n.RoomID, txnID)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+n.AccessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("matrix send failed, status code: %d, response: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+func TestMatrixNotifierMissingFields(t *testing.T) {
+	n := &MatrixNotifier{}
+	if err := n.Notify(context.Background(), &minerva.MinervaData{}); err == nil {
+		t.Fatal("expected an error when homeserver URL, room ID, and access token are unset")
+	}
+}
+
+func TestMatrixNotifierNotify(t *testing.T) {
+	var gotPath, gotAuth string
+	var gotEvent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&gotEvent)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	n := &MatrixNotifier{HomeserverURL: server.URL, RoomID: "!room:example.org", AccessToken: "tok123"}
+	data := &minerva.MinervaData{CurrentStatus: minerva.CurrentStatus{NextLocation: "Vault 76", ArrivalTime: "2026-07-26T12:00:00Z"}}
+
+	if err := n.Notify(context.Background(), data); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	wantPrefix := "/_matrix/client/v3/rooms/!room:example.org/send/m.room.message/"
+	if !strings.HasPrefix(gotPath, wantPrefix) {
+		t.Errorf("got path %q, want prefix %q", gotPath, wantPrefix)
+	}
+	if gotAuth != "Bearer tok123" {
+		t.Errorf("got Authorization %q, want %q", gotAuth, "Bearer tok123")
+	}
+	if gotEvent["msgtype"] != "m.text" {
+		t.Errorf("got msgtype %v, want m.text", gotEvent["msgtype"])
+	}
+	if !strings.Contains(gotEvent["body"].(string), "Vault 76") {
+		t.Errorf("got body %v, want it to mention Vault 76", gotEvent["body"])
+	}
+}
+
+func TestMatrixNotifierErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	n := &MatrixNotifier{HomeserverURL: server.URL, RoomID: "!room:example.org", AccessToken: "tok123"}
+	if err := n.Notify(context.Background(), &minerva.MinervaData{}); err == nil {
+		t.Fatal("expected an error for a non-200 response")
+	}
+}
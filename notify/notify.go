@@ -0,0 +1,23 @@
+// Package notify delivers MinervaData updates to external chat and activity
+// sinks (Discord, webhooks, Matrix, XMPP, ActivityPub).
+package notify
+
+import (
+	"context"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+	"github.com/cybellereaper/fo76-minerva-fetcher/state"
+)
+
+// Notifier pushes a MinervaData snapshot to a single sink.
+type Notifier interface {
+	Notify(ctx context.Context, data *minerva.MinervaData) error
+}
+
+// RotationNotifier is implemented by sinks that can render a distinct
+// message when Minerva moves to a new location, rather than the regular
+// status update. Callers should type-assert a Notifier to this interface
+// and fall back to Notify when it isn't implemented.
+type RotationNotifier interface {
+	NotifyRotation(ctx context.Context, rotation state.Rotation) error
+}
@@ -0,0 +1,57 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+// WebhookNotifier POSTs the raw MinervaData JSON to an arbitrary HTTP
+// endpoint, for sinks that don't need a Discord-shaped embed.
+type WebhookNotifier struct {
+	URL     string
+	Headers map[string]string
+}
+
+// Notify POSTs data as JSON to the configured URL.
+func (n *WebhookNotifier) Notify(ctx context.Context, data *minerva.MinervaData) error {
+	if n.URL == "" {
+		return fmt.Errorf("webhook URL is not set")
+	}
+
+	payloadBytes, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", n.URL, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range n.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status code: %d, response: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
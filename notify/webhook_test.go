@@ -0,0 +1,56 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+func TestWebhookNotifierMissingURL(t *testing.T) {
+	n := &WebhookNotifier{}
+	if err := n.Notify(context.Background(), &minerva.MinervaData{}); err == nil {
+		t.Fatal("expected an error when URL is empty")
+	}
+}
+
+func TestWebhookNotifierNotify(t *testing.T) {
+	var gotData minerva.MinervaData
+	var gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Api-Key")
+		if err := json.NewDecoder(r.Body).Decode(&gotData); err != nil {
+			t.Errorf("failed to decode payload: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	n := &WebhookNotifier{URL: server.URL, Headers: map[string]string{"X-Api-Key": "secret"}}
+	want := &minerva.MinervaData{CurrentStatus: minerva.CurrentStatus{NextLocation: "Vault 76"}}
+
+	if err := n.Notify(context.Background(), want); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if gotHeader != "secret" {
+		t.Errorf("got X-Api-Key %q, want %q", gotHeader, "secret")
+	}
+	if gotData.CurrentStatus != want.CurrentStatus {
+		t.Errorf("got CurrentStatus %+v, want %+v", gotData.CurrentStatus, want.CurrentStatus)
+	}
+}
+
+func TestWebhookNotifierErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	n := &WebhookNotifier{URL: server.URL}
+	if err := n.Notify(context.Background(), &minerva.MinervaData{}); err == nil {
+		t.Fatal("expected an error for a non-2xx response")
+	}
+}
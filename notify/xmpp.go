@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"gosrc.io/xmpp"
+	"gosrc.io/xmpp/stanza"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+// XMPPNotifier delivers a plain-text chat message to a single JID over
+// XMPP, connecting and disconnecting on every Notify call.
+type XMPPNotifier struct {
+	JID      string
+	Password string
+	ToJID    string
+	Insecure bool
+}
+
+// Notify connects to the configured XMPP account and sends a message
+// stanza to ToJID describing the current status. The message is sent from
+// the StreamManager's PostConnect hook, since the underlying client isn't
+// ready to write until the connect handshake run inside Run() completes.
+func (n *XMPPNotifier) Notify(ctx context.Context, data *minerva.MinervaData) error {
+	if n.JID == "" || n.ToJID == "" {
+		return fmt.Errorf("xmpp JID and destination JID must be set")
+	}
+
+	config := xmpp.Config{
+		Jid:        n.JID,
+		Credential: xmpp.Password(n.Password),
+		Insecure:   n.Insecure,
+	}
+
+	router := xmpp.NewRouter()
+	client, err := xmpp.NewClient(&config, router, func(err error) {})
+	if err != nil {
+		return fmt.Errorf("failed to create xmpp client: %w", err)
+	}
+
+	body := fmt.Sprintf("Minerva is heading to %s (%s)", data.CurrentStatus.NextLocation, data.CurrentStatus.ArrivalTime)
+	sendErrCh := make(chan error, 1)
+
+	cm := xmpp.NewStreamManager(client, func(s xmpp.Sender) {
+		msg := stanza.Message{
+			Attrs: stanza.Attrs{To: n.ToJID, Type: stanza.MessageTypeChat},
+			Body:  body,
+		}
+		sendErrCh <- s.Send(msg)
+	})
+
+	runErrCh := make(chan error, 1)
+	go func() { runErrCh <- cm.Run() }()
+	defer cm.Stop()
+
+	select {
+	case err := <-sendErrCh:
+		if err != nil {
+			return fmt.Errorf("failed to send xmpp message: %w", err)
+		}
+	case err := <-runErrCh:
+		if err != nil {
+			return fmt.Errorf("xmpp stream ended before connecting: %w", err)
+		}
+		return fmt.Errorf("xmpp stream closed before the message could be sent")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
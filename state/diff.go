@@ -0,0 +1,40 @@
+package state
+
+import "github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+
+// Change describes what, if anything, differs between two MinervaData
+// snapshots.
+type Change struct {
+	LocationChanged bool
+	ArrivalChanged  bool
+	NextSaleChanged bool
+}
+
+// Changed reports whether any tracked field differs.
+func (c Change) Changed() bool {
+	return c.LocationChanged || c.ArrivalChanged || c.NextSaleChanged
+}
+
+// Diff compares previous against current. previous may be nil, in which
+// case every field is reported as changed (there is nothing to compare
+// against yet).
+func Diff(previous, current *minerva.MinervaData) Change {
+	if previous == nil {
+		return Change{LocationChanged: true, ArrivalChanged: true, NextSaleChanged: true}
+	}
+
+	return Change{
+		LocationChanged: previous.CurrentStatus.NextLocation != current.CurrentStatus.NextLocation,
+		ArrivalChanged:  previous.CurrentStatus.ArrivalTime != current.CurrentStatus.ArrivalTime,
+		NextSaleChanged: nextSale(previous) != nextSale(current),
+	}
+}
+
+func nextSale(data *minerva.MinervaData) minerva.SaleInfo {
+	for _, sale := range data.SaleSchedule {
+		if sale.IsNext {
+			return sale
+		}
+	}
+	return minerva.SaleInfo{}
+}
@@ -0,0 +1,84 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+func TestDiffNilPrevious(t *testing.T) {
+	current := &minerva.MinervaData{CurrentStatus: minerva.CurrentStatus{NextLocation: "Vault 76"}}
+
+	change := Diff(nil, current)
+
+	if !change.Changed() {
+		t.Fatal("expected a nil previous snapshot to always report a change")
+	}
+	if !change.LocationChanged || !change.ArrivalChanged || !change.NextSaleChanged {
+		t.Errorf("expected every field to be reported changed, got %+v", change)
+	}
+}
+
+func TestDiffNoChange(t *testing.T) {
+	data := &minerva.MinervaData{
+		CurrentStatus: minerva.CurrentStatus{NextLocation: "Vault 76", ArrivalTime: "2026-07-26T12:00:00Z"},
+		SaleSchedule: []minerva.SaleInfo{
+			{SaleNumber: "1", Location: "Vault 76", IsNext: true},
+		},
+	}
+
+	change := Diff(data, data)
+
+	if change.Changed() {
+		t.Errorf("expected identical snapshots to report no change, got %+v", change)
+	}
+}
+
+func TestDiffLocationChanged(t *testing.T) {
+	previous := &minerva.MinervaData{CurrentStatus: minerva.CurrentStatus{NextLocation: "Vault 76"}}
+	current := &minerva.MinervaData{CurrentStatus: minerva.CurrentStatus{NextLocation: "Whitespring"}}
+
+	change := Diff(previous, current)
+
+	if !change.LocationChanged {
+		t.Error("expected LocationChanged to be true")
+	}
+	if change.ArrivalChanged || change.NextSaleChanged {
+		t.Errorf("expected only LocationChanged to be set, got %+v", change)
+	}
+}
+
+func TestDiffNextSaleChanged(t *testing.T) {
+	previous := &minerva.MinervaData{
+		SaleSchedule: []minerva.SaleInfo{
+			{SaleNumber: "1", Location: "Vault 76", IsNext: true},
+			{SaleNumber: "2", Location: "Whitespring"},
+		},
+	}
+	current := &minerva.MinervaData{
+		SaleSchedule: []minerva.SaleInfo{
+			{SaleNumber: "1", Location: "Vault 76"},
+			{SaleNumber: "2", Location: "Whitespring", IsNext: true},
+		},
+	}
+
+	change := Diff(previous, current)
+
+	if !change.NextSaleChanged {
+		t.Error("expected NextSaleChanged to be true")
+	}
+	if change.LocationChanged || change.ArrivalChanged {
+		t.Errorf("expected only NextSaleChanged to be set, got %+v", change)
+	}
+}
+
+func TestDiffNoNextSale(t *testing.T) {
+	previous := &minerva.MinervaData{SaleSchedule: []minerva.SaleInfo{{SaleNumber: "1"}}}
+	current := &minerva.MinervaData{SaleSchedule: []minerva.SaleInfo{{SaleNumber: "1"}}}
+
+	change := Diff(previous, current)
+
+	if change.NextSaleChanged {
+		t.Error("expected two schedules with no IsNext row to compare equal")
+	}
+}
@@ -0,0 +1,24 @@
+package state
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// HistoryHandler serves the store's recorded rotations as a JSON array.
+// The daemon's HTTP server mounts it, and so does a one-shot CLI run when
+// it is given a history address.
+func (s *Store) HistoryHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		history, err := s.History()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(history); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
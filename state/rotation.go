@@ -0,0 +1,10 @@
+package state
+
+import "time"
+
+// Rotation records a single location change in Minerva's current status.
+type Rotation struct {
+	From string    `json:"from"`
+	To   string    `json:"to"`
+	At   time.Time `json:"at"`
+}
@@ -0,0 +1,114 @@
+// Package state persists the last-seen MinervaData snapshot and a log of
+// location rotations, so repeated runs only notify on real changes.
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+// Store persists state as plain JSON files on disk: a single snapshot file
+// holding the last-seen MinervaData, and a history file holding the list
+// of rotations observed so far.
+type Store struct {
+	mu           sync.Mutex
+	snapshotPath string
+	historyPath  string
+}
+
+// NewStore builds a Store backed by the given snapshot and history file
+// paths. Neither file needs to exist yet.
+func NewStore(snapshotPath, historyPath string) *Store {
+	return &Store{snapshotPath: snapshotPath, historyPath: historyPath}
+}
+
+// LoadSnapshot returns the last-saved MinervaData, or nil if no snapshot
+// has been saved yet.
+func (s *Store) LoadSnapshot() (*minerva.MinervaData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := os.ReadFile(s.snapshotPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var data minerva.MinervaData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot: %w", err)
+	}
+
+	return &data, nil
+}
+
+// SaveSnapshot overwrites the last-seen MinervaData snapshot.
+func (s *Store) SaveSnapshot(data *minerva.MinervaData) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := json.MarshalIndent(data, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	if err := os.WriteFile(s.snapshotPath, raw, 0o644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// History returns every rotation recorded so far, oldest first.
+func (s *Store) History() ([]Rotation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.readHistory()
+}
+
+// AppendRotation records a new rotation at the end of the history file.
+func (s *Store) AppendRotation(r Rotation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history, err := s.readHistory()
+	if err != nil {
+		return err
+	}
+	history = append(history, r)
+
+	raw, err := json.MarshalIndent(history, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal history: %w", err)
+	}
+
+	if err := os.WriteFile(s.historyPath, raw, 0o644); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) readHistory() ([]Rotation, error) {
+	raw, err := os.ReadFile(s.historyPath)
+	if os.IsNotExist(err) {
+		return []Rotation{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	var history []Rotation
+	if err := json.Unmarshal(raw, &history); err != nil {
+		return nil, fmt.Errorf("failed to parse history: %w", err)
+	}
+
+	return history, nil
+}
@@ -0,0 +1,112 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cybellereaper/fo76-minerva-fetcher/minerva"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	dir := t.TempDir()
+	return NewStore(filepath.Join(dir, "snapshot.json"), filepath.Join(dir, "history.json"))
+}
+
+func TestStoreLoadSnapshotMissingFile(t *testing.T) {
+	s := newTestStore(t)
+
+	data, err := s.LoadSnapshot()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data != nil {
+		t.Errorf("expected nil snapshot when no file exists, got %+v", data)
+	}
+}
+
+func TestStoreSaveAndLoadSnapshotRoundTrip(t *testing.T) {
+	s := newTestStore(t)
+	want := &minerva.MinervaData{
+		CurrentStatus: minerva.CurrentStatus{NextLocation: "Vault 76", ArrivalTime: "2026-07-26T12:00:00Z"},
+		SaleSchedule:  []minerva.SaleInfo{{SaleNumber: "1", Location: "Vault 76", IsNext: true}},
+	}
+
+	if err := s.SaveSnapshot(want); err != nil {
+		t.Fatalf("SaveSnapshot: %v", err)
+	}
+
+	got, err := s.LoadSnapshot()
+	if err != nil {
+		t.Fatalf("LoadSnapshot: %v", err)
+	}
+	if got.CurrentStatus != want.CurrentStatus {
+		t.Errorf("got CurrentStatus %+v, want %+v", got.CurrentStatus, want.CurrentStatus)
+	}
+	if len(got.SaleSchedule) != 1 || got.SaleSchedule[0] != want.SaleSchedule[0] {
+		t.Errorf("got SaleSchedule %+v, want %+v", got.SaleSchedule, want.SaleSchedule)
+	}
+}
+
+func TestStoreLoadSnapshotCorruptFile(t *testing.T) {
+	s := newTestStore(t)
+	if err := os.WriteFile(s.snapshotPath, []byte("not json"), 0o644); err != nil {
+		t.Fatalf("failed to seed corrupt snapshot: %v", err)
+	}
+
+	if _, err := s.LoadSnapshot(); err == nil {
+		t.Fatal("expected an error for a corrupt snapshot file")
+	}
+}
+
+func TestStoreHistoryEmpty(t *testing.T) {
+	s := newTestStore(t)
+
+	history, err := s.History()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(history) != 0 {
+		t.Errorf("expected no rotations yet, got %+v", history)
+	}
+}
+
+func TestStoreAppendRotation(t *testing.T) {
+	s := newTestStore(t)
+	first := Rotation{From: "Vault 76", To: "Whitespring", At: time.Date(2026, 7, 26, 12, 0, 0, 0, time.UTC)}
+	second := Rotation{From: "Whitespring", To: "Vault 76", At: time.Date(2026, 7, 27, 12, 0, 0, 0, time.UTC)}
+
+	if err := s.AppendRotation(first); err != nil {
+		t.Fatalf("AppendRotation(first): %v", err)
+	}
+	if err := s.AppendRotation(second); err != nil {
+		t.Fatalf("AppendRotation(second): %v", err)
+	}
+
+	history, err := s.History()
+	if err != nil {
+		t.Fatalf("History: %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("got %d rotations, want 2", len(history))
+	}
+	if !history[0].At.Equal(first.At) || history[0].From != first.From || history[0].To != first.To {
+		t.Errorf("history[0] = %+v, want %+v", history[0], first)
+	}
+	if !history[1].At.Equal(second.At) || history[1].From != second.From || history[1].To != second.To {
+		t.Errorf("history[1] = %+v, want %+v", history[1], second)
+	}
+}
+
+func TestStoreHistoryCorruptFile(t *testing.T) {
+	s := newTestStore(t)
+	if err := os.WriteFile(s.historyPath, []byte("not json"), 0o644); err != nil {
+		t.Fatalf("failed to seed corrupt history: %v", err)
+	}
+
+	if _, err := s.History(); err == nil {
+		t.Fatal("expected an error for a corrupt history file")
+	}
+}